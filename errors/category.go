@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Category classifies a BizCode into a generic class of failure (input
+// validation, database, auth, ...). It is the second component of the
+// numeric Code produced by InitModule and is what the transport layer
+// uses to pick a default HTTP/gRPC status.
+//
+// Categories are allocated in blocks of 100 inside a Code, so a Category
+// value itself stays small (1, 2, 3, ...) and is multiplied out when the
+// Code is assembled.
+type Category uint32
+
+const (
+	CategoryInput Category = iota + 1
+	CategoryDB
+	CategoryResource
+	CategoryGRPC
+	CategoryAuth
+	CategorySystem
+	CategoryPubSub
+)
+
+// categoryNames maps the lowercase names accepted by the `category`
+// struct tag to their Category value. Populated with the built-in
+// categories and extendable via RegisterCategory.
+var categoryNames = map[string]Category{
+	"input":    CategoryInput,
+	"db":       CategoryDB,
+	"resource": CategoryResource,
+	"grpc":     CategoryGRPC,
+	"auth":     CategoryAuth,
+	"system":   CategorySystem,
+	"pubsub":   CategoryPubSub,
+}
+
+// RegisterCategory adds or overrides the Category resolved for name by
+// the `category` struct tag. Names are matched case-insensitively.
+func RegisterCategory(name string, category Category) {
+	categoryNames[strings.ToLower(name)] = category
+}
+
+// lookupCategory resolves a `category` struct tag value to a Category,
+// accepting either a registered name (e.g. "db") or a numeric literal
+// (e.g. "2"). It returns 0, false if tag is empty or unresolvable.
+func lookupCategory(tag string) (Category, bool) {
+	if tag == "" {
+		return 0, false
+	}
+
+	if n, err := strconv.ParseUint(tag, 10, 32); err == nil {
+		return Category(n), true
+	}
+
+	category, ok := categoryNames[strings.ToLower(tag)]
+	return category, ok
+}
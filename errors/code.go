@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strconv"
 )
 
 var logger defaultLogger
@@ -24,13 +25,22 @@ type Logger interface {
 
 type defaultLogger struct{}
 
-func (l *defaultLogger) PrintBizError(obj *ErrorInfo) {
+func (l *defaultLogger) PrintBizError(obj ErrorInfo) {
 	log.Println(obj.Uuid, obj.Value, obj.Err)
 }
 
+// LogBizError routes info through the package-level Logger, the same
+// path Wrap uses. Exposed so other packages (e.g. errors/transport) can
+// log a BizError exactly once before handling it.
+func LogBizError(info ErrorInfo) {
+	logger.PrintBizError(info)
+}
+
 const (
-	bizCodeKey    = "Key"
-	bizCodePrefix = "prefix"
+	bizCodeKey      = "Key"
+	bizCodePrefix   = "prefix"
+	bizCodeCategory = "category"
+	bizCodeDetail   = "detail"
 )
 
 // BizCode represents a business error code that can be used to create
@@ -38,27 +48,47 @@ const (
 type BizCode struct {
 	ctx context.Context
 
-	Key string // Unique identifier for the error code
+	Key  string // Unique identifier for the error code
+	Code uint32 // Numeric code composed of Scope, Category and Detail
 }
 
 func (r BizCode) Ctx(ctx context.Context) BizCode {
 	return BizCode{
-		Key: r.Key,
-		ctx: ctx,
+		Key:  r.Key,
+		Code: r.Code,
+		ctx:  ctx,
 	}
 }
 
+// CodeValue returns the numeric Code assigned to this BizCode by
+// InitModule.
+func (r BizCode) CodeValue() uint32 {
+	return r.Code
+}
+
+// DecomposeCode splits a numeric Code back into the Scope, Category and
+// Detail components InitModule composed it from.
+func DecomposeCode(code uint32) (scope, category, detail uint32) {
+	scope = code / 1_000_000
+	rem := code % 1_000_000
+	category = rem / 100
+	detail = rem % 100
+	return
+}
+
 // Wrap creates a new BizError with the given error message and captures
 // the current code location (file, line, function).
 // This allows attaching a specific error code to any error.
-func (r BizCode) Wrap(err error, obj ...interface{}) error {
+func (r BizCode) Wrap(err error, fields ...Field) error {
 	if err == nil {
 		return nil
 	}
 
 	newBizErr := &BizError{
-		key: r.Key,
-		err: err,
+		key:    r.Key,
+		code:   r.Code,
+		err:    err,
+		fields: fields,
 	}
 
 	var bizErr *BizError
@@ -67,20 +97,38 @@ func (r BizCode) Wrap(err error, obj ...interface{}) error {
 		newBizErr.uuid = bizErr.uuid
 	} else {
 		newBizErr.stack = callers()
-		newBizErr.uuid = "123"
+		newBizErr.uuid = idExtractor(r.ctx)
 	}
 
-	logger.PrintBizError(&ErrorInfo{
+	logger.PrintBizError(ErrorInfo{
 		Ctx:      r.ctx,
 		Err:      err,
 		Location: captureLocation(1),
-		Value:    obj,
+		Value:    fieldsToMap(newBizErr.Fields()),
 		Uuid:     newBizErr.uuid,
 	})
 
 	return newBizErr
 }
 
+// WrapValues is the pre-Field form of Wrap, kept for callers not yet
+// migrated to structured Field values. obj is read as alternating
+// string-key/value pairs, e.g. WrapValues(err, "orderId", 123); unpaired
+// trailing values and non-string keys are dropped. The stack and logged
+// Location it records start at WrapValues itself, not at its caller.
+//
+// Deprecated: use Wrap with F(key, value) fields instead.
+func (r BizCode) WrapValues(err error, obj ...interface{}) error {
+	fields := make([]Field, 0, len(obj)/2)
+	for i := 0; i+1 < len(obj); i += 2 {
+		if key, ok := obj[i].(string); ok {
+			fields = append(fields, F(key, obj[i+1]))
+		}
+	}
+
+	return r.Wrap(err, fields...)
+}
+
 // Equal checks if the provided error has the same error code as this BizCode.
 // This enables type-safe error comparison.
 func (r BizCode) Equal(err error) bool {
@@ -111,10 +159,27 @@ func (r BizCode) String() string {
 // InitModule recursively initializes all BizCode fields in the provided struct.
 // The prefix is prepended to all error codes to create namespaced error codes.
 //
+// scope is optional and identifies the module/service owning obj (e.g. a
+// value in 1-999, unique per service). When provided, it becomes the
+// Scope component of every BizCode.Code assigned within obj. Category is
+// picked up along the way from `category` tags on nested structs, and
+// Detail from a `detail` tag on each BizCode field; Code is then composed
+// as scope*1_000_000 + category*100 + detail.
+//
 // Parameters:
 //   - prefix: A string prefix to prepend to all error codes
 //   - obj: A pointer to a struct containing BizCode fields
-func InitModule(prefix string, obj interface{}) {
+//   - scope: An optional numeric scope shared by every code in obj
+func InitModule(prefix string, obj interface{}, scope ...uint32) {
+	var sc uint32
+	if len(scope) > 0 {
+		sc = scope[0]
+	}
+
+	initModule(prefix, sc, 0, obj)
+}
+
+func initModule(prefix string, scope uint32, category Category, obj interface{}) {
 	if obj == nil {
 		return
 	}
@@ -136,6 +201,10 @@ func InitModule(prefix string, obj interface{}) {
 			key := field.Tag.Get(bizCodeKey)
 			if key != "" {
 				fieldValue.FieldByName(bizCodeKey).SetString(fmt.Sprintf("%s%s", prefix, key))
+
+				detail, _ := strconv.ParseUint(field.Tag.Get(bizCodeDetail), 10, 32)
+				code := scope*1_000_000 + uint32(category)*100 + uint32(detail)
+				fieldValue.FieldByName("Code").SetUint(uint64(code))
 			}
 		}
 
@@ -146,8 +215,15 @@ func InitModule(prefix string, obj interface{}) {
 				nestedPrefix = fmt.Sprintf("%s%s", prefix, prefixTag)
 			}
 
+			nestedCategory := category
+			if catTag := field.Tag.Get(bizCodeCategory); catTag != "" {
+				if resolved, ok := lookupCategory(catTag); ok {
+					nestedCategory = resolved
+				}
+			}
+
 			if fieldValue.CanAddr() {
-				InitModule(nestedPrefix, fieldValue.Addr().Interface())
+				initModule(nestedPrefix, scope, nestedCategory, fieldValue.Addr().Interface())
 			}
 		}
 	}
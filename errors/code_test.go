@@ -2,6 +2,7 @@ package errors
 
 import (
 	"context"
+	stderrors "errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -91,6 +92,60 @@ func TestBizCode(t *testing.T) {
 	})
 }
 
+// Test struct combining category and detail tags for numeric codes
+type TestNumericCodes struct {
+	Auth struct {
+		InvalidToken BizCode `Key:"invalidToken" detail:"1"`
+		Expired      BizCode `Key:"expired" detail:"2"`
+	} `prefix:"auth" category:"auth"`
+
+	Order struct {
+		NotFound BizCode `Key:"notFound" detail:"1"`
+	} `prefix:"order" category:"2"`
+}
+
+func TestInitModuleNumericCode(t *testing.T) {
+	codes := &TestNumericCodes{}
+	InitModule("app", codes, 7)
+
+	t.Run("Named category resolves and composes with scope", func(t *testing.T) {
+		assert.Equal(t, uint32(7_000_000+uint32(CategoryAuth)*100+1), codes.Auth.InvalidToken.Code)
+		assert.Equal(t, uint32(7_000_000+uint32(CategoryAuth)*100+2), codes.Auth.Expired.Code)
+	})
+
+	t.Run("Numeric category tag is used as-is", func(t *testing.T) {
+		assert.Equal(t, uint32(7_000_000+200+1), codes.Order.NotFound.Code)
+	})
+
+	t.Run("DecomposeCode round-trips Scope/Category/Detail", func(t *testing.T) {
+		scope, category, detail := DecomposeCode(codes.Auth.InvalidToken.Code)
+		assert.Equal(t, uint32(7), scope)
+		assert.Equal(t, uint32(CategoryAuth), category)
+		assert.Equal(t, uint32(1), detail)
+	})
+
+	t.Run("Scope is omitted when not passed", func(t *testing.T) {
+		unscoped := &TestNumericCodes{}
+		InitModule("app", unscoped)
+		assert.Equal(t, uint32(CategoryAuth)*100+1, unscoped.Auth.InvalidToken.Code)
+	})
+}
+
+func TestRegisterCategory(t *testing.T) {
+	RegisterCategory("custom", Category(42))
+
+	type custom struct {
+		Failed BizCode `Key:"failed" detail:"5"`
+	}
+
+	holder := &struct {
+		Custom custom `prefix:"custom" category:"custom"`
+	}{}
+
+	InitModule("app", holder)
+	assert.Equal(t, uint32(42*100+5), holder.Custom.Failed.Code)
+}
+
 // Test error code combination usage
 func TestErrorCodeIntegration(t *testing.T) {
 	// Initialize error codes
@@ -100,7 +155,7 @@ func TestErrorCodeIntegration(t *testing.T) {
 	t.Run("Create and wrap errors", func(t *testing.T) {
 		// Create a user not found error
 		originalErr := assert.AnError
-		userNotFoundErr := errorCodes.User.NotFound.Wrap(originalErr, "user id", 12345)
+		userNotFoundErr := errorCodes.User.NotFound.Wrap(originalErr, F("userId", 12345))
 
 		// Verify that BizError was created correctly
 		assert.True(t, errorCodes.User.NotFound.Equal(userNotFoundErr))
@@ -110,10 +165,10 @@ func TestErrorCodeIntegration(t *testing.T) {
 	t.Run("Nested error wrapping", func(t *testing.T) {
 		// Create payment failed error
 		originalErr := assert.AnError
-		paymentErr := errorCodes.Order.Payment.Failed.Wrap(originalErr, "payment id", "P12345")
+		paymentErr := errorCodes.Order.Payment.Failed.Wrap(originalErr, F("paymentId", "P12345"))
 
 		// Wrap as order error
-		orderErr := errorCodes.Order.Invalid.Wrap(paymentErr, "order id", "O98765")
+		orderErr := errorCodes.Order.Invalid.Wrap(paymentErr, F("orderId", "O98765"))
 
 		// Verify error chain
 		assert.True(t, errorCodes.Order.Invalid.Equal(orderErr))
@@ -121,3 +176,37 @@ func TestErrorCodeIntegration(t *testing.T) {
 		assert.False(t, errorCodes.User.NotFound.Equal(orderErr))
 	})
 }
+
+// Test structured Field access across a wrap chain
+func TestBizErrorFields(t *testing.T) {
+	t.Run("Fields merge across the wrap chain, outer wins on collision", func(t *testing.T) {
+		inner := BizCode{Key: "fields.inner"}.Wrap(assert.AnError, F("orderId", "O1"), F("stage", "inner"))
+		outer := BizCode{Key: "fields.outer"}.Wrap(inner, F("stage", "outer"))
+
+		var bizErr *BizError
+		assert.True(t, stderrors.As(outer, &bizErr))
+
+		orderID, ok := bizErr.Field("orderId")
+		assert.True(t, ok)
+		assert.Equal(t, "O1", orderID)
+
+		stage, ok := bizErr.Field("stage")
+		assert.True(t, ok)
+		assert.Equal(t, "outer", stage)
+
+		_, ok = bizErr.Field("missing")
+		assert.False(t, ok)
+	})
+
+	t.Run("WrapValues keeps string-keyed pairs as Fields", func(t *testing.T) {
+		code := BizCode{Key: "fields.legacy"}
+		err := code.WrapValues(assert.AnError, "orderId", "O2", "unpaired")
+
+		var bizErr *BizError
+		assert.True(t, stderrors.As(err, &bizErr))
+
+		orderID, ok := bizErr.Field("orderId")
+		assert.True(t, ok)
+		assert.Equal(t, "O2", orderID)
+	})
+}
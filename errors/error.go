@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -11,10 +12,103 @@ import (
 // BizError represents a business error with a specific error code key,
 // an underlying error that provides the detailed message, and location information.
 type BizError struct {
-	key   string // Unique identifier for the error type
-	err   error  // Underlying error with detailed message
-	uuid  string //
-	stack *stack
+	key    string  // Unique identifier for the error type
+	code   uint32  // Numeric Scope/Category/Detail code, see BizCode.Code
+	err    error   // Underlying error with detailed message
+	uuid   string  //
+	stack  *stack
+	fields []Field // Key/value pairs passed to Wrap at this layer
+}
+
+// Code returns the numeric Scope/Category/Detail code attached to this
+// error by InitModule, so downstream systems can switch on it without
+// parsing the string Key.
+func (r *BizError) Code() uint32 {
+	return r.code
+}
+
+// UUID returns the identifier shared by every layer of this error's wrap
+// chain, so it can be correlated with the Uuid logged by Logger.
+func (r *BizError) UUID() string {
+	return r.uuid
+}
+
+// StackTrace returns the resolved call stack captured at the first Wrap
+// call of this error's chain.
+func (r *BizError) StackTrace() []Frame {
+	return r.stack.Frames()
+}
+
+// Fields returns the key/value pairs attached across this error's whole
+// wrap chain, innermost first; a field set by an outer Wrap overrides an
+// inner one with the same Key.
+func (r *BizError) Fields() []Field {
+	var chain []*BizError
+	for cur := r; cur != nil; {
+		chain = append(chain, cur)
+
+		var inner *BizError
+		if !errors.As(cur.err, &inner) {
+			break
+		}
+		cur = inner
+	}
+
+	merged := make([]Field, 0, len(chain))
+	index := make(map[string]int, len(chain))
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, field := range chain[i].fields {
+			if pos, ok := index[field.Key]; ok {
+				merged[pos] = field
+				continue
+			}
+			index[field.Key] = len(merged)
+			merged = append(merged, field)
+		}
+	}
+
+	return merged
+}
+
+// Field returns the value attached under key anywhere in this error's
+// wrap chain, per the same precedence as Fields.
+func (r *BizError) Field(key string) (interface{}, bool) {
+	for _, field := range r.Fields() {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+	return nil, false
+}
+
+// LocalizedMessage returns the message template registered for this
+// error's Key, formatted with the key/value pairs it was wrapped with,
+// in the language carried by ctx. If no template is registered for this
+// error, it walks down the wrap chain looking for one, finally falling
+// back to this error's own key if nothing matches.
+func (r *BizError) LocalizedMessage(ctx context.Context) string {
+	if msg, ok := r.localizedMessage(ctx); ok {
+		return msg
+	}
+	return r.key
+}
+
+func (r *BizError) localizedMessage(ctx context.Context) (string, bool) {
+	lang := languageFromContext(ctx)
+	tmpl, ok := lookupMessage(r.key, lang)
+	if !ok {
+		tmpl, ok = lookupMessage(r.key, DefaultLanguage)
+	}
+	if ok {
+		return formatTemplate(tmpl, fieldsToMap(r.Fields())), true
+	}
+
+	var inner *BizError
+	if errors.As(r.err, &inner) {
+		return inner.localizedMessage(ctx)
+	}
+
+	return "", false
 }
 
 // Error implements the error interface and returns the error message
@@ -10,21 +10,21 @@ import (
 // Create a standard error
 func a1() error {
 	bizError := BizCode{Key: "a1"}
-	return bizError.Wrap(stderrors.New("a1 error"), "a1")
+	return bizError.Wrap(stderrors.New("a1 error"), F("layer", "a1"))
 }
 
 // Wrap an existing BizError
 func a2() error {
 	bizError := BizCode{Key: "a2"}
 	err := a1()
-	return bizError.Wrap(err, "a2")
+	return bizError.Wrap(err, F("layer", "a2"))
 }
 
 // Wrap a BizError again
 func a3() error {
 	bizError := BizCode{Key: "a3"}
 	err := a2()
-	return bizError.Wrap(err, "a3")
+	return bizError.Wrap(err, F("layer", "a3"))
 }
 
 // Return nil error
@@ -79,7 +79,7 @@ func TestEqual(t *testing.T) {
 
 	t.Run("Direct comparison with same Key", func(t *testing.T) {
 		code := BizCode{Key: "same"}
-		err := code.Wrap(stderrors.New("test"), "value")
+		err := code.Wrap(stderrors.New("test"), F("key", "value"))
 		assert.True(t, code.Equal(err), "Same key should return true")
 	})
 }
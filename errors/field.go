@@ -0,0 +1,27 @@
+package errors
+
+// Field is a structured key/value pair attached to a BizError via Wrap,
+// akin to zap.Field or slog.Attr.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// F creates a Field for Wrap, e.g. Wrap(err, F("orderId", 123)).
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// fieldsToMap flattens fields into a map for logging or template
+// placeholder lookup. Later entries win on duplicate keys.
+func fieldsToMap(fields []Field) map[string]interface{} {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	m := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		m[f.Key] = f.Value
+	}
+	return m
+}
@@ -0,0 +1,105 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// DefaultLanguage is used when a context carries no language, or when no
+// template is registered for the language it does carry.
+const DefaultLanguage = "en"
+
+type languageContextKey struct{}
+
+// WithLanguage returns a context carrying lang, so that BizCode.Message
+// and BizError.LocalizedMessage can resolve the right template for it.
+// HTTP middleware typically calls this after parsing an Accept-Language
+// header.
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageContextKey{}, lang)
+}
+
+// languageFromContext returns the language stored in ctx by WithLanguage,
+// or DefaultLanguage if ctx is nil or carries none.
+func languageFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return DefaultLanguage
+	}
+
+	if lang, ok := ctx.Value(languageContextKey{}).(string); ok && lang != "" {
+		return lang
+	}
+
+	return DefaultLanguage
+}
+
+var (
+	messagesMu sync.RWMutex
+	messages   = map[string]map[string]string{} // lang -> Key -> template
+)
+
+// RegisterMessages adds or overrides the message templates for lang,
+// keyed by the same Key produced by InitModule. Templates may reference
+// named placeholders such as "{orderId}", which LocalizedMessage fills
+// in from the key/value pairs attached at Wrap time, and Message from the
+// fields passed to it.
+func RegisterMessages(lang string, templates map[string]string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+
+	set, ok := messages[lang]
+	if !ok {
+		set = make(map[string]string, len(templates))
+		messages[lang] = set
+	}
+
+	for key, tmpl := range templates {
+		set[key] = tmpl
+	}
+}
+
+// lookupMessage returns the raw template registered for key under lang.
+func lookupMessage(key, lang string) (string, bool) {
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+
+	set, ok := messages[lang]
+	if !ok {
+		return "", false
+	}
+
+	tmpl, ok := set[key]
+	return tmpl, ok
+}
+
+var placeholderPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// formatTemplate replaces "{name}" placeholders in tmpl with the
+// matching entry from fields, leaving unmatched placeholders untouched.
+func formatTemplate(tmpl string, fields map[string]interface{}) string {
+	return placeholderPattern.ReplaceAllStringFunc(tmpl, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		if value, ok := fields[name]; ok {
+			return fmt.Sprint(value)
+		}
+		return placeholder
+	})
+}
+
+// Message formats the template registered for r.Key under the language
+// carried by ctx (falling back to DefaultLanguage, then to r.Key itself
+// if no template is registered at all), filling named placeholders from
+// fields.
+func (r BizCode) Message(ctx context.Context, fields ...Field) string {
+	tmpl, ok := lookupMessage(r.Key, languageFromContext(ctx))
+	if !ok {
+		tmpl, ok = lookupMessage(r.Key, DefaultLanguage)
+	}
+	if !ok {
+		return r.Key
+	}
+
+	return formatTemplate(tmpl, fieldsToMap(fields))
+}
@@ -0,0 +1,49 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBizCodeMessage(t *testing.T) {
+	code := BizCode{Key: "i18n.orderNotFound"}
+	RegisterMessages("en", map[string]string{code.Key: "Order {orderId} was not found"})
+	RegisterMessages("zh", map[string]string{code.Key: "订单 {orderId} 不存在"})
+
+	t.Run("uses language from context", func(t *testing.T) {
+		ctx := WithLanguage(context.Background(), "zh")
+		assert.Equal(t, "订单 123 不存在", code.Message(ctx, F("orderId", 123)))
+	})
+
+	t.Run("falls back to default language", func(t *testing.T) {
+		ctx := WithLanguage(context.Background(), "fr")
+		assert.Equal(t, "Order 123 was not found", code.Message(ctx, F("orderId", 123)))
+	})
+
+	t.Run("falls back to key when unregistered", func(t *testing.T) {
+		unregistered := BizCode{Key: "i18n.unregistered"}
+		assert.Equal(t, "i18n.unregistered", unregistered.Message(context.Background()))
+	})
+}
+
+func TestBizErrorLocalizedMessage(t *testing.T) {
+	RegisterMessages("en", map[string]string{"i18n.paymentFailed": "Payment {paymentId} failed"})
+
+	code := BizCode{Key: "i18n.paymentFailed"}
+	err := code.Wrap(assert.AnError, F("paymentId", "P1"))
+
+	var bizErr *BizError
+	assert.True(t, stderrors.As(err, &bizErr))
+	assert.Equal(t, "Payment P1 failed", bizErr.LocalizedMessage(context.Background()))
+
+	t.Run("walks wrap chain to find a template", func(t *testing.T) {
+		outer := BizCode{Key: "i18n.unregisteredOuter"}.Wrap(err)
+
+		var outerBizErr *BizError
+		assert.True(t, stderrors.As(outer, &outerBizErr))
+		assert.Equal(t, "Payment P1 failed", outerBizErr.LocalizedMessage(context.Background()))
+	})
+}
@@ -0,0 +1,50 @@
+package errors
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// IDExtractor derives the correlation identifier for a newly created
+// BizError chain from ctx.
+type IDExtractor func(context.Context) string
+
+// idExtractor is the package-level extractor used by Wrap; override it
+// with WithIDExtractor.
+var idExtractor IDExtractor = defaultIDExtractor
+
+// WithIDExtractor overrides how the identifier for a newly created
+// BizError chain is derived from context. A nil extractor restores the
+// default. Call it during startup, before the first Wrap.
+func WithIDExtractor(extractor IDExtractor) {
+	if extractor == nil {
+		extractor = defaultIDExtractor
+	}
+	idExtractor = extractor
+}
+
+// defaultIDExtractor prefers the trace ID of the OpenTelemetry span
+// carried by ctx, so server logs and client-visible errors share one
+// correlation token. If ctx carries no span, it falls back to a
+// time-ordered UUIDv7 so logs can still be grep-sorted.
+func defaultIDExtractor(ctx context.Context) string {
+	if ctx != nil {
+		if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+			return sc.TraceID().String()
+		}
+	}
+
+	return newID()
+}
+
+// newID generates a time-ordered identifier for errors with no usable
+// trace context.
+func newID() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.NewString()
+	}
+	return id.String()
+}
@@ -0,0 +1,52 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestDefaultIDExtractor(t *testing.T) {
+	t.Run("two errors wrapped without a trace context get distinct ids", func(t *testing.T) {
+		code := BizCode{Key: "id.test"}
+		err1 := code.Wrap(assert.AnError)
+		err2 := code.Wrap(assert.AnError)
+
+		var bizErr1, bizErr2 *BizError
+		assert.True(t, stderrors.As(err1, &bizErr1))
+		assert.True(t, stderrors.As(err2, &bizErr2))
+		assert.True(t, bizErr1.UUID() != bizErr2.UUID())
+	})
+
+	t.Run("prefers the trace ID from an OpenTelemetry span context", func(t *testing.T) {
+		traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		assert.True(t, err == nil)
+
+		sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID})
+		ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+		code := BizCode{Key: "id.test"}.Ctx(ctx)
+		wrapped := code.Wrap(assert.AnError)
+
+		var bizErr *BizError
+		assert.True(t, stderrors.As(wrapped, &bizErr))
+		assert.Equal(t, traceID.String(), bizErr.UUID())
+	})
+}
+
+func TestWithIDExtractor(t *testing.T) {
+	WithIDExtractor(func(ctx context.Context) string {
+		return "fixed-id"
+	})
+	defer WithIDExtractor(nil)
+
+	code := BizCode{Key: "id.custom"}
+	err := code.Wrap(assert.AnError)
+
+	var bizErr *BizError
+	assert.True(t, stderrors.As(err, &bizErr))
+	assert.Equal(t, "fixed-id", bizErr.UUID())
+}
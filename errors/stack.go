@@ -0,0 +1,80 @@
+package errors
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// MaxStackDepth bounds how many program counters callers captures at the
+// first Wrap call in a chain. Override it before the first Wrap if the
+// default isn't enough for deeply recursive call chains.
+var MaxStackDepth = 32
+
+// Frame is one resolved stack frame.
+type Frame struct {
+	Func string
+	File string
+	Line int
+}
+
+// stack holds the raw program counters captured at the first Wrap call
+// of a BizError chain. Frames are resolved lazily from them since most
+// errors are never formatted with %+v.
+type stack struct {
+	pcs []uintptr
+}
+
+// callers captures the current call stack, skipping runtime.Callers,
+// callers itself, and BizCode.Wrap, so for a direct Wrap call the first
+// resolved Frame is the user's call site rather than a frame inside this
+// package. For an error created through WrapValues the first Frame is
+// WrapValues itself.
+func callers() *stack {
+	pcs := make([]uintptr, MaxStackDepth)
+	n := runtime.Callers(3, pcs)
+	return &stack{pcs: pcs[:n]}
+}
+
+// Frames resolves the captured program counters into Frame values.
+func (s *stack) Frames() []Frame {
+	if s == nil || len(s.pcs) == 0 {
+		return nil
+	}
+
+	framesIter := runtime.CallersFrames(s.pcs)
+	frames := make([]Frame, 0, len(s.pcs))
+	for {
+		frame, more := framesIter.Next()
+		frames = append(frames, Frame{
+			Func: trimFuncName(frame.Function),
+			File: frame.File,
+			Line: frame.Line,
+		})
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+// trimFuncName drops the package path from a fully-qualified function
+// name, keeping e.g. "errors.a1" instead of "github.com/.../errors.a1".
+func trimFuncName(full string) string {
+	if idx := strings.LastIndex(full, "/"); idx >= 0 {
+		return full[idx+1:]
+	}
+	return full
+}
+
+// Format writes one "\n\tfunc\n\t\tfile:line" entry per frame, in the
+// style of github.com/pkg/errors and gravitational/trace.
+func (s *stack) Format(st fmt.State, verb rune) {
+	if s == nil {
+		return
+	}
+
+	for _, frame := range s.Frames() {
+		_, _ = fmt.Fprintf(st, "\n\t%s\n\t\t%s:%d", frame.Func, frame.File, frame.Line)
+	}
+}
@@ -0,0 +1,47 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func wrapAtThisLine() error {
+	code := BizCode{Key: "stack.test"}
+	return code.Wrap(assert.AnError)
+}
+
+func TestStackTrace(t *testing.T) {
+	err := wrapAtThisLine()
+
+	var bizErr *BizError
+	assert.True(t, stderrors.As(err, &bizErr))
+
+	frames := bizErr.StackTrace()
+	assert.True(t, len(frames) > 0, "expected at least one frame")
+	assert.True(t, strings.HasSuffix(frames[0].Func, "wrapAtThisLine"), "top frame should be the Wrap call site, got %q", frames[0].Func)
+}
+
+func TestStackFormatVerbose(t *testing.T) {
+	err := wrapAtThisLine()
+
+	formatted := fmt.Sprintf("%+v", err)
+	assert.True(t, strings.HasPrefix(formatted, "stack.test\n\t"))
+	assert.True(t, strings.Contains(formatted, "wrapAtThisLine"))
+}
+
+func TestStackNotRecapturedOnRewrap(t *testing.T) {
+	inner := wrapAtThisLine()
+
+	outerCode := BizCode{Key: "stack.outer"}
+	outer := outerCode.Wrap(inner)
+
+	var innerErr, outerErr *BizError
+	assert.True(t, stderrors.As(inner, &innerErr))
+	assert.True(t, stderrors.As(outer, &outerErr))
+
+	assert.Equal(t, innerErr.StackTrace(), outerErr.StackTrace())
+}
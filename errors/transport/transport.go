@@ -0,0 +1,179 @@
+// Package transport converts *errors.BizError values into gRPC and HTTP
+// responses, picking a default status from the Category component of the
+// error's numeric Code and falling back to Internal/500 when a category
+// has no mapping registered.
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bizerrors "github.com/yusnower/bizgo/errors"
+)
+
+var mappingMu sync.RWMutex
+
+var httpMapping = map[uint32]int{
+	uint32(bizerrors.CategoryInput):    http.StatusBadRequest,
+	uint32(bizerrors.CategoryAuth):     http.StatusUnauthorized,
+	uint32(bizerrors.CategoryResource): http.StatusNotFound,
+	uint32(bizerrors.CategoryDB):       http.StatusInternalServerError,
+	uint32(bizerrors.CategorySystem):   http.StatusInternalServerError,
+	uint32(bizerrors.CategoryGRPC):     http.StatusInternalServerError,
+	uint32(bizerrors.CategoryPubSub):   http.StatusInternalServerError,
+}
+
+var grpcMapping = map[uint32]codes.Code{
+	uint32(bizerrors.CategoryInput):    codes.InvalidArgument,
+	uint32(bizerrors.CategoryAuth):     codes.Unauthenticated,
+	uint32(bizerrors.CategoryResource): codes.NotFound,
+	uint32(bizerrors.CategoryDB):       codes.Internal,
+	uint32(bizerrors.CategorySystem):   codes.Internal,
+	uint32(bizerrors.CategoryGRPC):     codes.Internal,
+	uint32(bizerrors.CategoryPubSub):   codes.Internal,
+}
+
+// RegisterHTTPMapping overrides the HTTP status returned for errors whose
+// Code decomposes to category.
+func RegisterHTTPMapping(category uint32, httpStatus int) {
+	mappingMu.Lock()
+	defer mappingMu.Unlock()
+	httpMapping[category] = httpStatus
+}
+
+// RegisterGRPCMapping overrides the gRPC status code returned for errors
+// whose Code decomposes to category.
+func RegisterGRPCMapping(category uint32, code codes.Code) {
+	mappingMu.Lock()
+	defer mappingMu.Unlock()
+	grpcMapping[category] = code
+}
+
+// HTTPStatus returns the HTTP status registered for err's Category,
+// defaulting to 500 if none is registered.
+func HTTPStatus(err *bizerrors.BizError) int {
+	_, category, _ := bizerrors.DecomposeCode(err.Code())
+
+	mappingMu.RLock()
+	defer mappingMu.RUnlock()
+	if status, ok := httpMapping[category]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// GRPCCode returns the gRPC status code registered for err's Category,
+// defaulting to codes.Internal if none is registered.
+func GRPCCode(err *bizerrors.BizError) codes.Code {
+	_, category, _ := bizerrors.DecomposeCode(err.Code())
+
+	mappingMu.RLock()
+	defer mappingMu.RUnlock()
+	if code, ok := grpcMapping[category]; ok {
+		return code
+	}
+	return codes.Internal
+}
+
+// envelope is the stable JSON body written for every BizError response.
+type envelope struct {
+	Code    uint32 `json:"code"`
+	Key     string `json:"key"`
+	Message string `json:"message"`
+	UUID    string `json:"uuid"`
+}
+
+func newEnvelope(ctx context.Context, err *bizerrors.BizError) envelope {
+	return envelope{
+		Code:    err.Code(),
+		Key:     err.Error(),
+		Message: err.LocalizedMessage(ctx),
+		UUID:    err.UUID(),
+	}
+}
+
+func logOnce(ctx context.Context, err *bizerrors.BizError) {
+	bizerrors.LogBizError(bizerrors.ErrorInfo{
+		Ctx:  ctx,
+		Err:  err,
+		Uuid: err.UUID(),
+	})
+}
+
+// ToGRPCStatus converts err into a *status.Status using GRPCCode and its
+// localized message.
+func ToGRPCStatus(ctx context.Context, err *bizerrors.BizError) *status.Status {
+	return status.New(GRPCCode(err), err.LocalizedMessage(ctx))
+}
+
+// ToGRPCError converts err into the error a gRPC handler should return.
+func ToGRPCError(ctx context.Context, err *bizerrors.BizError) error {
+	return ToGRPCStatus(ctx, err).Err()
+}
+
+// WriteHTTPError writes err to w with HTTPStatus(err) and the stable
+// JSON envelope.
+func WriteHTTPError(ctx context.Context, w http.ResponseWriter, err *bizerrors.BizError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(HTTPStatus(err))
+	_ = json.NewEncoder(w).Encode(newEnvelope(ctx, err))
+}
+
+// UnaryServerInterceptor catches a *BizError returned by a unary handler,
+// logs it once, and converts it to the matching gRPC status.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		var bizErr *bizerrors.BizError
+		if !stderrors.As(err, &bizErr) {
+			return resp, err
+		}
+
+		logOnce(ctx, bizErr)
+		return resp, ToGRPCError(ctx, bizErr)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+
+		var bizErr *bizerrors.BizError
+		if !stderrors.As(err, &bizErr) {
+			return err
+		}
+
+		logOnce(ss.Context(), bizErr)
+		return ToGRPCError(ss.Context(), bizErr)
+	}
+}
+
+// HandlerFunc is like http.HandlerFunc but may return an error. Handler
+// adapts it into an http.Handler that catches a returned *BizError.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// Handler adapts fn into an http.Handler, logging and writing a returned
+// *BizError through WriteHTTPError.
+func Handler(fn HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := fn(w, r)
+
+		var bizErr *bizerrors.BizError
+		if !stderrors.As(err, &bizErr) {
+			return
+		}
+
+		logOnce(r.Context(), bizErr)
+		WriteHTTPError(r.Context(), w, bizErr)
+	})
+}
@@ -0,0 +1,82 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	bizerrors "github.com/yusnower/bizgo/errors"
+)
+
+type testCodes struct {
+	Resource struct {
+		NotFound bizerrors.BizCode `Key:"notFound" detail:"1"`
+	} `prefix:"resource" category:"resource"`
+
+	Auth struct {
+		Unauthorized bizerrors.BizCode `Key:"unauthorized" detail:"1"`
+	} `prefix:"auth" category:"auth"`
+}
+
+func TestHTTPStatus(t *testing.T) {
+	var c testCodes
+	bizerrors.InitModule("test.", &c)
+
+	t.Run("uses the built-in category mapping", func(t *testing.T) {
+		err := c.Resource.NotFound.Wrap(assert.AnError)
+
+		var bizErr *bizerrors.BizError
+		assert.True(t, stderrors.As(err, &bizErr))
+		assert.Equal(t, 404, HTTPStatus(bizErr))
+	})
+
+	t.Run("falls back to 500 for an unmapped category", func(t *testing.T) {
+		unmapped := bizerrors.BizCode{Key: "transport.unmapped"}
+		err := unmapped.Wrap(assert.AnError)
+
+		var bizErr *bizerrors.BizError
+		assert.True(t, stderrors.As(err, &bizErr))
+		assert.Equal(t, 500, HTTPStatus(bizErr))
+	})
+
+	t.Run("RegisterHTTPMapping overrides the default", func(t *testing.T) {
+		RegisterHTTPMapping(uint32(bizerrors.CategoryAuth), 499)
+		err := c.Auth.Unauthorized.Wrap(assert.AnError)
+
+		var bizErr *bizerrors.BizError
+		assert.True(t, stderrors.As(err, &bizErr))
+		assert.Equal(t, 499, HTTPStatus(bizErr))
+	})
+}
+
+func TestGRPCCode(t *testing.T) {
+	var c testCodes
+	bizerrors.InitModule("test.", &c)
+
+	wrapped := c.Auth.Unauthorized.Wrap(assert.AnError)
+	var bizErr *bizerrors.BizError
+	assert.True(t, stderrors.As(wrapped, &bizErr))
+	assert.Equal(t, codes.Unauthenticated, GRPCCode(bizErr))
+}
+
+func TestWriteHTTPError(t *testing.T) {
+	code := bizerrors.BizCode{Key: "transport.test"}
+	bizerrors.RegisterMessages("en", map[string]string{"transport.test": "boom"})
+
+	err := code.Wrap(assert.AnError)
+	var bizErr *bizerrors.BizError
+	assert.True(t, stderrors.As(err, &bizErr))
+
+	rec := httptest.NewRecorder()
+	WriteHTTPError(context.Background(), rec, bizErr)
+
+	var body envelope
+	assert.True(t, json.Unmarshal(rec.Body.Bytes(), &body) == nil)
+	assert.Equal(t, "transport.test", body.Key)
+	assert.Equal(t, "boom", body.Message)
+}